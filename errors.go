@@ -0,0 +1,59 @@
+package labelselector
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Position describes a location in the parsed input, modeled after
+// go/scanner.Position.
+type Position struct {
+	Offset int // byte offset, starting at 0
+	Line   int // line number, starting at 1
+	Column int // column number (in runes), starting at 1
+}
+
+// ParseError is a single error encountered while parsing a selector,
+// together with the position it occurred at.
+type ParseError struct {
+	Pos   Position
+	Msg   string
+	Token string
+}
+
+// Error implements the error interface with a "line:col: msg" prefix.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Pos.Line, e.Pos.Column, e.Msg)
+}
+
+// ErrorList is a list of *ParseError, accumulated when the parser runs in
+// CollectErrors mode.
+type ErrorList []*ParseError
+
+// Error implements the error interface, reporting the first error and how
+// many more were found.
+func (l ErrorList) Error() string {
+	switch len(l) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l[0].Error()
+	}
+	var b strings.Builder
+	b.WriteString(l[0].Error())
+	fmt.Fprintf(&b, " (and %d more errors)", len(l)-1)
+	return b.String()
+}
+
+// Mode controls how the parser behaves once it encounters an error.
+type Mode int
+
+const (
+	// FailFast stops parsing and returns the first error encountered. It is
+	// the default mode.
+	FailFast Mode = iota
+	// CollectErrors makes the parser skip past a malformed requirement and
+	// keep going, so every error in the input is reported at once via
+	// Parser.Errors().
+	CollectErrors
+)
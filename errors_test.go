@@ -0,0 +1,44 @@
+package labelselector
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseErrorPosition(t *testing.T) {
+	_, err := ParseString("foo, bar=")
+	require.Error(t, err)
+	parseErr, ok := err.(*ParseError)
+	require.True(t, ok, "expected a *ParseError, got %T", err)
+	require.Equal(t, 1, parseErr.Pos.Line)
+	require.Equal(t, len("foo, bar=")+1, parseErr.Pos.Column)
+	require.Equal(t, "expect identifier after equal operator", parseErr.Msg)
+	require.Equal(t, "1:10: expect identifier after equal operator", parseErr.Error())
+}
+
+func TestParserCollectErrorsMode(t *testing.T) {
+	parser := NewParser(strings.NewReader("foo=, bar, baz<"))
+	parser.SetMode(CollectErrors)
+
+	selector, err := parser.Parse()
+	require.Error(t, err)
+
+	errList, ok := err.(ErrorList)
+	require.True(t, ok, "expected an ErrorList, got %T", err)
+	require.Len(t, errList, 2)
+	require.Equal(t, "expect identifier after equal operator", errList[0].Msg)
+	require.Equal(t, "expect identifier after < operator", errList[1].Msg)
+
+	require.Equal(t, errList, parser.Errors())
+	require.Equal(t, []Requirement{{Key: "bar", Operation: OperationExists}}, selector.requirements)
+}
+
+func TestErrorListError(t *testing.T) {
+	list := ErrorList{
+		&ParseError{Pos: Position{Line: 1, Column: 1}, Msg: "first"},
+		&ParseError{Pos: Position{Line: 1, Column: 5}, Msg: "second"},
+	}
+	require.Equal(t, "1:1: first (and 1 more errors)", list.Error())
+}
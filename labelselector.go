@@ -1,8 +1,50 @@
 package labelselector
 
+import "strconv"
+
 // LabelSelector is a set of label requirements
 type LabelSelector struct {
-	Requirements []Requirement
+	requirements []Requirement
+}
+
+// Requirements returns the parsed requirements that make up the selector.
+func (s LabelSelector) Requirements() []Requirement {
+	return s.requirements
+}
+
+// Matches returns true if labels satisfies every requirement of the
+// selector. An empty selector matches everything.
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	ls := mapLabels(labels)
+	for _, r := range s.requirements {
+		if !r.Matches(ls) {
+			return false
+		}
+	}
+	return true
+}
+
+// Labels is the interface a label source has to implement so it can be
+// matched against a Requirement or LabelSelector. It allows callers to plug
+// in sources other than a plain map[string]string.
+type Labels interface {
+	// Get returns the value for key and whether it was present.
+	Get(key string) (string, bool)
+	// Has returns whether key is present.
+	Has(key string) bool
+}
+
+// mapLabels adapts a map[string]string to the Labels interface.
+type mapLabels map[string]string
+
+func (m mapLabels) Get(key string) (string, bool) {
+	v, ok := m[key]
+	return v, ok
+}
+
+func (m mapLabels) Has(key string) bool {
+	_, ok := m[key]
+	return ok
 }
 
 type Requirement struct {
@@ -12,6 +54,82 @@ type Requirement struct {
 	Operation Operation
 }
 
+// Matches returns true if labels satisfies the requirement. Ordering
+// operations (LowerThan, LowerThanEqual, GreaterThan, GreaterThanEqual)
+// parse both the requirement's Value and the labeled value as base-10
+// int64s and return false, rather than an error, if either side is
+// non-numeric or the key is missing.
+func (r Requirement) Matches(labels Labels) bool {
+	switch r.Operation {
+	case OperationExists:
+		return labels.Has(r.Key)
+	case OperationNotExists:
+		return !labels.Has(r.Key)
+	case OperationEquals:
+		if !labels.Has(r.Key) {
+			return false
+		}
+		v, _ := labels.Get(r.Key)
+		return v == r.Value
+	case OperationNotEquals:
+		if !labels.Has(r.Key) {
+			return true
+		}
+		v, _ := labels.Get(r.Key)
+		return v != r.Value
+	case OperationIn:
+		if !labels.Has(r.Key) {
+			return false
+		}
+		v, _ := labels.Get(r.Key)
+		return containsString(r.Values, v)
+	case OperationNotIn:
+		if !labels.Has(r.Key) {
+			return true
+		}
+		v, _ := labels.Get(r.Key)
+		return !containsString(r.Values, v)
+	case OperationLowerThan, OperationLowerThanEqual, OperationGreaterThan, OperationGreaterThanEqual:
+		return r.matchesOrdering(labels)
+	}
+	return false
+}
+
+func (r Requirement) matchesOrdering(labels Labels) bool {
+	lv, ok := labels.Get(r.Key)
+	if !ok {
+		return false
+	}
+	labelValue, err := strconv.ParseInt(lv, 10, 64)
+	if err != nil {
+		return false
+	}
+	reqValue, err := strconv.ParseInt(r.Value, 10, 64)
+	if err != nil {
+		return false
+	}
+	switch r.Operation {
+	case OperationLowerThan:
+		return labelValue < reqValue
+	case OperationLowerThanEqual:
+		return labelValue <= reqValue
+	case OperationGreaterThan:
+		return labelValue > reqValue
+	case OperationGreaterThanEqual:
+		return labelValue >= reqValue
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
 type Operation string
 
 const (
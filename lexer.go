@@ -14,15 +14,20 @@ const (
 	ILLEGAL Token = iota
 	EOF
 	WS
-	IDENT            // identifier
-	COMMA            // ,
-	EXCLAMATION_MARK // !
-	IN               // in
-	NOT              // not
-	EQUAL            // = or ==
-	NOT_EQUAL        // !=
-	OPENING_BRACKET  // (
-	CLOSING_BRACKET  // )
+	IDENT              // identifier
+	COMMA              // ,
+	EXCLAMATION_MARK   // !
+	IN                 // in
+	NOT                // not
+	NOT_IN             // notin
+	EQUAL              // = or ==
+	NOT_EQUAL          // !=
+	OPENING_BRACKET    // (
+	CLOSING_BRACKET    // )
+	LOWER_THAN         // <
+	LOWER_THAN_EQUAL   // <=
+	GREATER_THAN       // >
+	GREATER_THAN_EQUAL // >=
 )
 
 var eof = rune(0)
@@ -43,28 +48,55 @@ func isValidIdentRune(ch rune) bool {
 
 type Lexer struct {
 	r *bufio.Reader
+
+	pos      Position // position of the rune that read() will return next
+	prevPos  Position // pos before the last read(), so unread() can restore it
+	tokStart Position // position of the start of the token returned by the last Next()
+	consumed bool     // whether the last read() actually consumed a rune
 }
 
 // NewLexer returns a new instance of Lexer.
 func NewLexer(r io.Reader) *Lexer {
-	return &Lexer{r: bufio.NewReader(r)}
+	return &Lexer{r: bufio.NewReader(r), pos: Position{Line: 1, Column: 1}}
 }
 
+// Pos returns the position of the start of the token last returned by Next.
+func (s *Lexer) Pos() Position { return s.tokStart }
+
 // read reads the next rune from the bufferred reader.
 // Returns the rune(0) if an error occurs (or io.EOF is returned).
 func (s *Lexer) read() rune {
-	ch, _, err := s.r.ReadRune()
+	ch, size, err := s.r.ReadRune()
 	if err != nil {
+		s.consumed = false
 		return eof
 	}
+	s.prevPos = s.pos
+	s.pos.Offset += size
+	if ch == '\n' {
+		s.pos.Line++
+		s.pos.Column = 1
+	} else {
+		s.pos.Column++
+	}
+	s.consumed = true
 	return ch
 }
 
-// unread places the previously read rune back on the reader.
-func (s *Lexer) unread() { _ = s.r.UnreadRune() }
+// unread places the previously read rune back on the reader. It is a no-op
+// if the last read() hit EOF, since then there was no rune to put back.
+func (s *Lexer) unread() {
+	if !s.consumed {
+		return
+	}
+	_ = s.r.UnreadRune()
+	s.pos = s.prevPos
+	s.consumed = false
+}
 
 // Next returns the next token and literal value.
 func (s *Lexer) Next() (tok Token, lit string) {
+	s.tokStart = s.pos
 	// Read the next rune.
 	ch := s.read()
 	switch {
@@ -92,6 +124,20 @@ func (s *Lexer) Next() (tok Token, lit string) {
 		}
 		s.unread()
 		return EQUAL, string(ch)
+	case ch == '<':
+		ch := s.read()
+		if ch == '=' {
+			return LOWER_THAN_EQUAL, "<="
+		}
+		s.unread()
+		return LOWER_THAN, "<"
+	case ch == '>':
+		ch := s.read()
+		if ch == '=' {
+			return GREATER_THAN_EQUAL, ">="
+		}
+		s.unread()
+		return GREATER_THAN, ">"
 	case ch == ',':
 		return COMMA, string(ch)
 	case ch == '(':
@@ -148,6 +194,8 @@ func (s *Lexer) scanIdent() (tok Token, lit string) {
 	switch strings.ToUpper(buf.String()) {
 	case "NOT":
 		return NOT, buf.String()
+	case "NOTIN":
+		return NOT_IN, buf.String()
 	case "IN":
 		return IN, buf.String()
 	}
@@ -157,21 +205,26 @@ func (s *Lexer) scanIdent() (tok Token, lit string) {
 }
 
 func (s *Lexer) scanQuotedIdent() (tok Token, lit string) {
-	// Create a buffer and read the current character into it.
-	var (
-		buf  bytes.Buffer
-		last rune
-	)
+	// Create a buffer and read runes into it until the closing quote,
+	// unescaping \" to " and \\ to \ along the way so this is the inverse
+	// of quoteIdent's escaping. A backslash always consumes the following
+	// rune verbatim, so an escaped backslash can never be mistaken for the
+	// start of an escaped quote.
+	var buf bytes.Buffer
 
-	// Read every subsequent ident character into the buffer.
-	// Non-ident characters and EOF will cause the loop to exit.
 	for {
-		if ch := s.read(); ch == eof || (ch == '"' && last != '\\') {
+		ch := s.read()
+		if ch == eof || ch == '"' {
 			break
-		} else {
-			_, _ = buf.WriteRune(ch)
-			last = ch
 		}
+		if ch == '\\' {
+			if next := s.read(); next != eof {
+				ch = next
+			} else {
+				break
+			}
+		}
+		_, _ = buf.WriteRune(ch)
 	}
 	return IDENT, buf.String()
 }
@@ -0,0 +1,157 @@
+package labelselector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequirementMatches(t *testing.T) {
+	cases := []struct {
+		name     string
+		req      Requirement
+		labels   map[string]string
+		expected bool
+	}{
+		{
+			name:     "exists matches when key present",
+			req:      Requirement{Key: "foo", Operation: OperationExists},
+			labels:   map[string]string{"foo": "bar"},
+			expected: true,
+		},
+		{
+			name:     "exists fails when key missing",
+			req:      Requirement{Key: "foo", Operation: OperationExists},
+			labels:   map[string]string{},
+			expected: false,
+		},
+		{
+			name:     "not-exists fails when key present",
+			req:      Requirement{Key: "foo", Operation: OperationNotExists},
+			labels:   map[string]string{"foo": "bar"},
+			expected: false,
+		},
+		{
+			name:     "equals matches equal value",
+			req:      Requirement{Key: "foo", Operation: OperationEquals, Value: "bar"},
+			labels:   map[string]string{"foo": "bar"},
+			expected: true,
+		},
+		{
+			name:     "equals fails when key missing",
+			req:      Requirement{Key: "foo", Operation: OperationEquals, Value: "bar"},
+			labels:   map[string]string{},
+			expected: false,
+		},
+		{
+			name:     "not-equals matches when key missing",
+			req:      Requirement{Key: "foo", Operation: OperationNotEquals, Value: "bar"},
+			labels:   map[string]string{},
+			expected: true,
+		},
+		{
+			name:     "in matches one of the values",
+			req:      Requirement{Key: "foo", Operation: OperationIn, Values: []string{"a", "b"}},
+			labels:   map[string]string{"foo": "b"},
+			expected: true,
+		},
+		{
+			name:     "notin matches when value not in list",
+			req:      Requirement{Key: "foo", Operation: OperationNotIn, Values: []string{"a", "b"}},
+			labels:   map[string]string{"foo": "c"},
+			expected: true,
+		},
+		{
+			name:     "lower than matches numerically",
+			req:      Requirement{Key: "foo", Operation: OperationLowerThan, Value: "10"},
+			labels:   map[string]string{"foo": "5"},
+			expected: true,
+		},
+		{
+			name:     "lower than equal matches equal values",
+			req:      Requirement{Key: "foo", Operation: OperationLowerThanEqual, Value: "5"},
+			labels:   map[string]string{"foo": "5"},
+			expected: true,
+		},
+		{
+			name:     "greater than fails numerically",
+			req:      Requirement{Key: "foo", Operation: OperationGreaterThan, Value: "10"},
+			labels:   map[string]string{"foo": "5"},
+			expected: false,
+		},
+		{
+			name:     "greater than equal matches equal values",
+			req:      Requirement{Key: "foo", Operation: OperationGreaterThanEqual, Value: "5"},
+			labels:   map[string]string{"foo": "5"},
+			expected: true,
+		},
+		{
+			name:     "ordering fails on non-numeric label value",
+			req:      Requirement{Key: "foo", Operation: OperationLowerThan, Value: "10"},
+			labels:   map[string]string{"foo": "bar"},
+			expected: false,
+		},
+		{
+			name:     "ordering fails on non-numeric requirement value",
+			req:      Requirement{Key: "foo", Operation: OperationLowerThan, Value: "bar"},
+			labels:   map[string]string{"foo": "5"},
+			expected: false,
+		},
+		{
+			name:     "ordering fails when key missing",
+			req:      Requirement{Key: "foo", Operation: OperationLowerThan, Value: "10"},
+			labels:   map[string]string{},
+			expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.req.Matches(mapLabels(tc.labels)))
+		})
+	}
+}
+
+func TestLabelSelectorMatches(t *testing.T) {
+	cases := []struct {
+		name     string
+		selector LabelSelector
+		labels   map[string]string
+		expected bool
+	}{
+		{
+			name:     "empty selector matches everything",
+			selector: LabelSelector{},
+			labels:   map[string]string{"foo": "bar"},
+			expected: true,
+		},
+		{
+			name: "all requirements must match",
+			selector: LabelSelector{
+				requirements: []Requirement{
+					{Key: "foo", Operation: OperationEquals, Value: "bar"},
+					{Key: "baz", Operation: OperationExists},
+				},
+			},
+			labels:   map[string]string{"foo": "bar", "baz": "qux"},
+			expected: true,
+		},
+		{
+			name: "one failing requirement fails the selector",
+			selector: LabelSelector{
+				requirements: []Requirement{
+					{Key: "foo", Operation: OperationEquals, Value: "bar"},
+					{Key: "baz", Operation: OperationExists},
+				},
+			},
+			labels:   map[string]string{"foo": "bar"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.selector.Matches(tc.labels))
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package labelselector
 
 import (
-	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -20,12 +19,37 @@ func ParseString(str string) (LabelSelector, error) {
 
 // NewParser creates a new parser instance
 func NewParser(input io.Reader) *Parser {
-	return &Parser{NewLexer(input)}
+	return &Parser{lexer: NewLexer(input)}
 }
 
 // Parser is capable of parsing a label selector expression
 type Parser struct {
-	lexer *Lexer
+	lexer    *Lexer
+	mode     Mode
+	errs     ErrorList
+	lastTok  Token // token returned by the most recent next(), used for error recovery
+	validate bool
+}
+
+// SetMode controls whether the parser stops at the first error (FailFast,
+// the default) or keeps parsing and collects every error it encounters
+// (CollectErrors).
+func (p *Parser) SetMode(m Mode) { p.mode = m }
+
+// SetValidate controls whether Parse runs Validate on the parsed selector
+// and returns the resulting ValidationErrors as its error. Off by default.
+func (p *Parser) SetValidate(v bool) { p.validate = v }
+
+// Errors returns every error collected so far. In FailFast mode this holds
+// at most one error.
+func (p *Parser) Errors() ErrorList { return p.errs }
+
+// newError records a *ParseError at the current lexer position and returns
+// it so call sites can still treat it as a plain error.
+func (p *Parser) newError(token, msg string) *ParseError {
+	err := &ParseError{Pos: p.lexer.Pos(), Msg: msg, Token: token}
+	p.errs = append(p.errs, err)
+	return err
 }
 
 // scan gets the next token
@@ -40,9 +64,20 @@ func (p *Parser) next() (Token, string) {
 	for tok == WS {
 		tok, lit = p.scan()
 	}
+	p.lastTok = tok
 	return tok, lit
 }
 
+// recover resyncs the token stream after an error so CollectErrors mode can
+// resume parsing at the next requirement instead of aborting. If the token
+// that caused the error was already a COMMA or EOF, the stream is already
+// at a requirement boundary and nothing further needs to be discarded.
+func (p *Parser) recover() {
+	for p.lastTok != COMMA && p.lastTok != EOF {
+		p.next()
+	}
+}
+
 // Parse actually parses the input and returns the resulting LabelSelector
 func (p *Parser) Parse() (LabelSelector, error) {
 	selector := LabelSelector{}
@@ -59,7 +94,11 @@ func (p *Parser) Parse() (LabelSelector, error) {
 			break
 		}
 		if tok == ILLEGAL {
-			return selector, errors.New("illegal token")
+			err := p.newError(lit, "illegal token")
+			if p.mode == FailFast {
+				return selector, err
+			}
+			continue
 		}
 		// there are two cases now:
 		// * we see a '!' -> this will be a not-exist requirement
@@ -69,9 +108,13 @@ func (p *Parser) Parse() (LabelSelector, error) {
 			// its a not-exist requirement
 			req, err := p.parseNotExistsRequirement()
 			if err != nil {
-				return selector, err
+				if p.mode == FailFast {
+					return selector, err
+				}
+				p.recover()
+				continue
 			}
-			selector.Requirements = append(selector.Requirements, req)
+			selector.requirements = append(selector.requirements, req)
 		case IDENT:
 			// we have a identifier so its one of
 			// * equal requirement
@@ -107,8 +150,14 @@ func (p *Parser) Parse() (LabelSelector, error) {
 				// its a greater than equal requirement
 				req, err = p.parseGreaterThanEqualRequirement(key)
 			case NOT:
-				// its a not-in requirement
+				// its a not-in requirement ("not in (...)")
 				req, err = p.parseNotInRequirement(key)
+			case NOT_IN:
+				// its a not-in requirement ("notin (...)")
+				req, err = p.parseInRequirement(key)
+				if err == nil {
+					req.Operation = OperationNotIn
+				}
 			case COMMA, EOF:
 				// its a exists requirement
 				req = Requirement{
@@ -116,12 +165,25 @@ func (p *Parser) Parse() (LabelSelector, error) {
 					Operation: OperationExists,
 				}
 			default:
-				return selector, fmt.Errorf("unexpected token '%s'", lit)
+				err = p.newError(lit, fmt.Sprintf("unexpected token '%s'", lit))
 			}
 			if err != nil {
-				return selector, err
+				if p.mode == FailFast {
+					return selector, err
+				}
+				p.recover()
+				continue
 			}
-			selector.Requirements = append(selector.Requirements, req)
+			selector.requirements = append(selector.requirements, req)
+		}
+	}
+
+	if p.mode == CollectErrors && len(p.errs) > 0 {
+		return selector, p.errs
+	}
+	if p.validate {
+		if verrs := Validate(selector); len(verrs) > 0 {
+			return selector, verrs
 		}
 	}
 	return selector, nil
@@ -130,7 +192,7 @@ func (p *Parser) Parse() (LabelSelector, error) {
 func (p *Parser) parseNotEqualRequirement(key string) (req Requirement, err error) {
 	tok, lit := p.next()
 	if tok != IDENT {
-		return req, errors.New("expect identifier after not-equal operator")
+		return req, p.newError(lit, "expect identifier after not-equal operator")
 	}
 	req = Requirement{
 		Key:       key,
@@ -143,7 +205,7 @@ func (p *Parser) parseNotEqualRequirement(key string) (req Requirement, err erro
 func (p *Parser) parseEqualRequirement(key string) (req Requirement, err error) {
 	tok, lit := p.next()
 	if tok != IDENT {
-		return req, errors.New("expect identifier after equal operator")
+		return req, p.newError(lit, "expect identifier after equal operator")
 	}
 	req = Requirement{
 		Key:       key,
@@ -156,7 +218,7 @@ func (p *Parser) parseEqualRequirement(key string) (req Requirement, err error)
 func (p *Parser) parseLowerThanRequirement(key string) (req Requirement, err error) {
 	tok, lit := p.next()
 	if tok != IDENT {
-		return req, errors.New("expect identifier after < operator")
+		return req, p.newError(lit, "expect identifier after < operator")
 	}
 	req = Requirement{
 		Key:       key,
@@ -169,7 +231,7 @@ func (p *Parser) parseLowerThanRequirement(key string) (req Requirement, err err
 func (p *Parser) parseLowerThanEqualRequirement(key string) (req Requirement, err error) {
 	tok, lit := p.next()
 	if tok != IDENT {
-		return req, errors.New("expect identifier after <= operator")
+		return req, p.newError(lit, "expect identifier after <= operator")
 	}
 	req = Requirement{
 		Key:       key,
@@ -182,7 +244,7 @@ func (p *Parser) parseLowerThanEqualRequirement(key string) (req Requirement, er
 func (p *Parser) parseGreaterThanRequirement(key string) (req Requirement, err error) {
 	tok, lit := p.next()
 	if tok != IDENT {
-		return req, errors.New("expect identifier after > operator")
+		return req, p.newError(lit, "expect identifier after > operator")
 	}
 	req = Requirement{
 		Key:       key,
@@ -195,7 +257,7 @@ func (p *Parser) parseGreaterThanRequirement(key string) (req Requirement, err e
 func (p *Parser) parseGreaterThanEqualRequirement(key string) (req Requirement, err error) {
 	tok, lit := p.next()
 	if tok != IDENT {
-		return req, errors.New("expect identifier after >= operator")
+		return req, p.newError(lit, "expect identifier after >= operator")
 	}
 	req = Requirement{
 		Key:       key,
@@ -215,7 +277,7 @@ func (p *Parser) parseIdentList() (list []string, err error) {
 		} else if tok == IDENT {
 			list = append(list, lit)
 		} else {
-			return nil, fmt.Errorf("unexpected token in value list (%s)", lit)
+			return nil, p.newError(lit, fmt.Sprintf("unexpected token in value list (%s)", lit))
 		}
 	}
 	return list, nil
@@ -224,7 +286,7 @@ func (p *Parser) parseIdentList() (list []string, err error) {
 func (p *Parser) parseNotExistsRequirement() (req Requirement, err error) {
 	tok, lit := p.next()
 	if tok != IDENT {
-		return req, errors.New("expect identifier after exclamation mark")
+		return req, p.newError(lit, "expect identifier after exclamation mark")
 	}
 	return Requirement{
 		Key:       lit,
@@ -237,9 +299,9 @@ func (p *Parser) parseInRequirement(key string) (req Requirement, err error) {
 		Key:       key,
 		Operation: OperationIn,
 	}
-	tok, _ := p.next()
+	tok, lit := p.next()
 	if tok != OPENING_BRACKET {
-		return req, errors.New("expect opening bracket after in operator")
+		return req, p.newError(lit, "expect opening bracket after in operator")
 	}
 	list, err := p.parseIdentList()
 	if err != nil {
@@ -252,7 +314,7 @@ func (p *Parser) parseInRequirement(key string) (req Requirement, err error) {
 func (p *Parser) parseNotInRequirement(key string) (req Requirement, err error) {
 	tok, lit := p.next()
 	if tok != IN {
-		return req, fmt.Errorf("require 'IN' after 'NOT' got '%s'", lit)
+		return req, p.newError(lit, fmt.Sprintf("require 'IN' after 'NOT' got '%s'", lit))
 	}
 	req, err = p.parseInRequirement(key)
 	if err != nil {
@@ -1,7 +1,6 @@
 package labelselector
 
 import (
-	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -12,13 +11,13 @@ func TestParser(t *testing.T) {
 		name             string
 		input            string
 		expectedSelector LabelSelector
-		expectedError    error
+		expectedErrorMsg string
 	}{
 		{
 			name:  "one equals test",
 			input: `foo=bar`,
 			expectedSelector: LabelSelector{
-				Requirements: []Requirement{
+				requirements: []Requirement{
 					{
 						Key:       "foo",
 						Operation: OperationEquals,
@@ -31,7 +30,7 @@ func TestParser(t *testing.T) {
 			name:  "one lower than test",
 			input: `foo < 5`,
 			expectedSelector: LabelSelector{
-				Requirements: []Requirement{
+				requirements: []Requirement{
 					{
 						Key:       "foo",
 						Operation: OperationLowerThan,
@@ -44,7 +43,7 @@ func TestParser(t *testing.T) {
 			name:  "one lower than equal test",
 			input: `foo <= 5`,
 			expectedSelector: LabelSelector{
-				Requirements: []Requirement{
+				requirements: []Requirement{
 					{
 						Key:       "foo",
 						Operation: OperationLowerThanEqual,
@@ -57,7 +56,7 @@ func TestParser(t *testing.T) {
 			name:  "one greater than test",
 			input: `foo > 5`,
 			expectedSelector: LabelSelector{
-				Requirements: []Requirement{
+				requirements: []Requirement{
 					{
 						Key:       "foo",
 						Operation: OperationGreaterThan,
@@ -70,7 +69,7 @@ func TestParser(t *testing.T) {
 			name:  "one greater than equal test",
 			input: `foo >= 5`,
 			expectedSelector: LabelSelector{
-				Requirements: []Requirement{
+				requirements: []Requirement{
 					{
 						Key:       "foo",
 						Operation: OperationGreaterThanEqual,
@@ -83,7 +82,7 @@ func TestParser(t *testing.T) {
 			name:  "one equals test with '=='",
 			input: `foo == bar`,
 			expectedSelector: LabelSelector{
-				Requirements: []Requirement{
+				requirements: []Requirement{
 					{
 						Key:       "foo",
 						Operation: OperationEquals,
@@ -96,7 +95,7 @@ func TestParser(t *testing.T) {
 			name:  "one equals test with extra whitespaces",
 			input: ` foo  = bar   `,
 			expectedSelector: LabelSelector{
-				Requirements: []Requirement{
+				requirements: []Requirement{
 					{
 						Key:       "foo",
 						Operation: OperationEquals,
@@ -109,7 +108,7 @@ func TestParser(t *testing.T) {
 			name:  "one not equals test",
 			input: `foo != bar`,
 			expectedSelector: LabelSelector{
-				Requirements: []Requirement{
+				requirements: []Requirement{
 					{
 						Key:       "foo",
 						Operation: OperationNotEquals,
@@ -122,7 +121,7 @@ func TestParser(t *testing.T) {
 			name:  "one existance test",
 			input: `foo`,
 			expectedSelector: LabelSelector{
-				Requirements: []Requirement{
+				requirements: []Requirement{
 					{
 						Operation: OperationExists,
 						Key:       "foo",
@@ -134,7 +133,7 @@ func TestParser(t *testing.T) {
 			name:  "one existance test with quoted name",
 			input: `"foo bar"`,
 			expectedSelector: LabelSelector{
-				Requirements: []Requirement{
+				requirements: []Requirement{
 					{
 						Operation: OperationExists,
 						Key:       "foo bar",
@@ -146,7 +145,7 @@ func TestParser(t *testing.T) {
 			name:  "one non-existance test",
 			input: `!foo`,
 			expectedSelector: LabelSelector{
-				Requirements: []Requirement{
+				requirements: []Requirement{
 					{
 						Operation: OperationNotExists,
 						Key:       "foo",
@@ -158,7 +157,7 @@ func TestParser(t *testing.T) {
 			name:  "one in test",
 			input: `foo in (a, b, c)`,
 			expectedSelector: LabelSelector{
-				Requirements: []Requirement{
+				requirements: []Requirement{
 					{
 						Operation: OperationIn,
 						Key:       "foo",
@@ -171,7 +170,7 @@ func TestParser(t *testing.T) {
 			name:  "one notin test",
 			input: `foo notin (a, b, c)`,
 			expectedSelector: LabelSelector{
-				Requirements: []Requirement{
+				requirements: []Requirement{
 					{
 						Operation: OperationNotIn,
 						Key:       "foo",
@@ -184,7 +183,7 @@ func TestParser(t *testing.T) {
 			name:  "multiple checks",
 			input: `foo, bar, !baz, bla=blub`,
 			expectedSelector: LabelSelector{
-				Requirements: []Requirement{
+				requirements: []Requirement{
 					{
 						Operation: OperationExists,
 						Key:       "foo",
@@ -206,71 +205,78 @@ func TestParser(t *testing.T) {
 			},
 		},
 		{
-			name:          "illegal characters are rejected",
-			input:         `❤`,
-			expectedError: errors.New("illegal token"),
+			name:             "illegal characters are rejected",
+			input:            `❤`,
+			expectedErrorMsg: "illegal token",
 		},
 		{
-			name:          "not-existance test needs argument",
-			input:         `!`,
-			expectedError: errors.New("expect identifier after exclamation mark"),
+			name:             "not-existance test needs argument",
+			input:            `!`,
+			expectedErrorMsg: "expect identifier after exclamation mark",
 		},
 		{
-			name:          "identifier needs operator if any",
-			input:         `foo bar`,
-			expectedError: errors.New("unexpected token 'bar'"),
+			name:             "identifier needs operator if any",
+			input:            `foo bar`,
+			expectedErrorMsg: "unexpected token 'bar'",
 		},
 		{
-			name:          "equal operator needs argument",
-			input:         `foo=`,
-			expectedError: errors.New("expect identifier after equal operator"),
+			name:             "equal operator needs argument",
+			input:            `foo=`,
+			expectedErrorMsg: "expect identifier after equal operator",
 		},
 		{
-			name:          "lower than operator needs argument",
-			input:         `foo<`,
-			expectedError: errors.New("expect identifier after < operator"),
+			name:             "lower than operator needs argument",
+			input:            `foo<`,
+			expectedErrorMsg: "expect identifier after < operator",
 		},
 		{
-			name:          "lower than equal operator needs argument",
-			input:         `foo<=`,
-			expectedError: errors.New("expect identifier after <= operator"),
+			name:             "lower than equal operator needs argument",
+			input:            `foo<=`,
+			expectedErrorMsg: "expect identifier after <= operator",
 		},
 		{
-			name:          "greater than operator needs argument",
-			input:         `foo>`,
-			expectedError: errors.New("expect identifier after > operator"),
+			name:             "greater than operator needs argument",
+			input:            `foo>`,
+			expectedErrorMsg: "expect identifier after > operator",
 		},
 		{
-			name:          "greater than equal operator needs argument",
-			input:         `foo>=`,
-			expectedError: errors.New("expect identifier after >= operator"),
+			name:             "greater than equal operator needs argument",
+			input:            `foo>=`,
+			expectedErrorMsg: "expect identifier after >= operator",
 		},
 		{
-			name:          "not equal operator needs argument",
-			input:         `foo!=`,
-			expectedError: errors.New("expect identifier after not-equal operator"),
+			name:             "not equal operator needs argument",
+			input:            `foo!=`,
+			expectedErrorMsg: "expect identifier after not-equal operator",
 		},
 		{
-			name:          "in operator needs argument",
-			input:         `foo in`,
-			expectedError: errors.New("expect opening bracket after in operator"),
+			name:             "in operator needs argument",
+			input:            `foo in`,
+			expectedErrorMsg: "expect opening bracket after in operator",
 		},
 		{
-			name:          "notin operator needs argument",
-			input:         `foo notin`,
-			expectedError: errors.New("expect opening bracket after in operator"),
+			name:             "notin operator needs argument",
+			input:            `foo notin`,
+			expectedErrorMsg: "expect opening bracket after in operator",
 		},
 		{
-			name:          "in operator needs properly formatted argument list",
-			input:         `foo in (]`,
-			expectedError: errors.New("unexpected token in value list (])"),
+			name:             "in operator needs properly formatted argument list",
+			input:            `foo in (]`,
+			expectedErrorMsg: "unexpected token in value list (])",
 		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			selector, err := ParseString(tc.input)
-			require.Equal(t, tc.expectedError, err)
+			if tc.expectedErrorMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				parseErr, ok := err.(*ParseError)
+				require.True(t, ok, "expected a *ParseError, got %T", err)
+				require.Equal(t, tc.expectedErrorMsg, parseErr.Msg)
+			}
 			require.Equal(t, tc.expectedSelector, selector)
 		})
 	}
@@ -0,0 +1,124 @@
+package labelselector
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Selector is the interface implemented by LabelSelector. Downstream code
+// should prefer depending on Selector over the concrete type, following the
+// shape of Kubernetes' labels.Selector.
+type Selector interface {
+	Matches(labels map[string]string) bool
+	Add(r ...Requirement) Selector
+	Requirements() []Requirement
+	String() string
+	Empty() bool
+}
+
+var _ Selector = LabelSelector{}
+
+// Add returns a new Selector with r appended to the existing requirements.
+func (s LabelSelector) Add(r ...Requirement) Selector {
+	merged := make([]Requirement, 0, len(s.requirements)+len(r))
+	merged = append(merged, s.requirements...)
+	merged = append(merged, r...)
+	return LabelSelector{requirements: merged}
+}
+
+// Empty returns true if the selector has no requirements, i.e. it matches
+// every label set.
+func (s LabelSelector) Empty() bool {
+	return len(s.requirements) == 0
+}
+
+// Everything returns a Selector that matches all label sets.
+func Everything() Selector {
+	return LabelSelector{}
+}
+
+// Nothing returns a Selector that matches no label set.
+func Nothing() Selector {
+	return nothingSelector{}
+}
+
+// nothingSelector is a sentinel Selector that never matches and can't be
+// turned into one that does by adding requirements to it.
+type nothingSelector struct{}
+
+func (nothingSelector) Matches(map[string]string) bool { return false }
+func (n nothingSelector) Add(...Requirement) Selector  { return n }
+func (nothingSelector) Requirements() []Requirement    { return nil }
+func (nothingSelector) String() string                 { return "" }
+func (nothingSelector) Empty() bool                    { return false }
+
+var _ Selector = nothingSelector{}
+
+// ParseSelector parses a label selector expression and returns it as a
+// Selector, the interface-typed alternative to Parse.
+func ParseSelector(input io.Reader) (Selector, error) {
+	selector, err := Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	return selector, nil
+}
+
+// ParseSelectorString parses a label selector expression from a string and
+// returns it as a Selector, the interface-typed alternative to ParseString.
+func ParseSelectorString(str string) (Selector, error) {
+	selector, err := ParseString(str)
+	if err != nil {
+		return nil, err
+	}
+	return selector, nil
+}
+
+// NewRequirement builds a Requirement for key, op and vals, validating that
+// op is given the number of values it accepts (Exists/NotExists: none;
+// Equals/NotEquals/the ordering operators: exactly one; In/NotIn: at least
+// one) and that key and vals satisfy the same rules as Validate, so the
+// result is guaranteed to round-trip through String() and Parse.
+func NewRequirement(key string, op Operation, vals ...string) (Requirement, error) {
+	if errs := validateKey(key); len(errs) > 0 {
+		return Requirement{}, errs
+	}
+
+	req := Requirement{Key: key, Operation: op}
+	switch op {
+	case OperationExists, OperationNotExists:
+		if len(vals) != 0 {
+			return Requirement{}, fmt.Errorf("operation %q takes no values, got %d", op, len(vals))
+		}
+	case OperationEquals, OperationNotEquals:
+		if len(vals) != 1 {
+			return Requirement{}, fmt.Errorf("operation %q takes exactly one value, got %d", op, len(vals))
+		}
+		if errs := validateValue(vals[0]); len(errs) > 0 {
+			return Requirement{}, errs
+		}
+		req.Value = vals[0]
+	case OperationLowerThan, OperationLowerThanEqual, OperationGreaterThan, OperationGreaterThanEqual:
+		if len(vals) != 1 {
+			return Requirement{}, fmt.Errorf("operation %q takes exactly one value, got %d", op, len(vals))
+		}
+		if _, err := strconv.ParseInt(vals[0], 10, 64); err != nil {
+			return Requirement{}, fmt.Errorf("operation %q requires an int64 value: %w", op, err)
+		}
+		req.Value = vals[0]
+	case OperationIn, OperationNotIn:
+		if len(vals) < 1 {
+			return Requirement{}, fmt.Errorf("operation %q requires at least one value", op)
+		}
+		for _, v := range vals {
+			if errs := validateValue(v); len(errs) > 0 {
+				return Requirement{}, errs
+			}
+		}
+		req.Values = vals
+	default:
+		return Requirement{}, fmt.Errorf("unknown operation %q", op)
+	}
+	return req, nil
+}
@@ -0,0 +1,136 @@
+package labelselector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRequirement(t *testing.T) {
+	cases := []struct {
+		name     string
+		key      string
+		op       Operation
+		vals     []string
+		expected Requirement
+		wantErr  bool
+	}{
+		{
+			name:     "exists takes no values",
+			key:      "foo",
+			op:       OperationExists,
+			expected: Requirement{Key: "foo", Operation: OperationExists},
+		},
+		{
+			name:    "exists rejects a value",
+			key:     "foo",
+			op:      OperationExists,
+			vals:    []string{"bar"},
+			wantErr: true,
+		},
+		{
+			name:     "equals takes exactly one value",
+			key:      "foo",
+			op:       OperationEquals,
+			vals:     []string{"bar"},
+			expected: Requirement{Key: "foo", Operation: OperationEquals, Value: "bar"},
+		},
+		{
+			name:    "equals rejects zero values",
+			key:     "foo",
+			op:      OperationEquals,
+			wantErr: true,
+		},
+		{
+			name:    "equals rejects more than one value",
+			key:     "foo",
+			op:      OperationEquals,
+			vals:    []string{"a", "b"},
+			wantErr: true,
+		},
+		{
+			name:     "in takes one or more values",
+			key:      "foo",
+			op:       OperationIn,
+			vals:     []string{"a", "b"},
+			expected: Requirement{Key: "foo", Operation: OperationIn, Values: []string{"a", "b"}},
+		},
+		{
+			name:    "in rejects zero values",
+			key:     "foo",
+			op:      OperationIn,
+			wantErr: true,
+		},
+		{
+			name:     "lower than requires a numeric value",
+			key:      "foo",
+			op:       OperationLowerThan,
+			vals:     []string{"5"},
+			expected: Requirement{Key: "foo", Operation: OperationLowerThan, Value: "5"},
+		},
+		{
+			name:    "lower than rejects a non-numeric value",
+			key:     "foo",
+			op:      OperationLowerThan,
+			vals:    []string{"bar"},
+			wantErr: true,
+		},
+		{
+			name:     "lower than accepts a negative value",
+			key:      "foo",
+			op:       OperationLowerThan,
+			vals:     []string{"-5"},
+			expected: Requirement{Key: "foo", Operation: OperationLowerThan, Value: "-5"},
+		},
+		{
+			name:    "invalid key is rejected",
+			key:     "foo/bar/baz",
+			op:      OperationExists,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := NewRequirement(tc.key, tc.op, tc.vals...)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, req)
+		})
+	}
+}
+
+func TestSelectorAddAndEmpty(t *testing.T) {
+	require.True(t, Everything().Empty())
+	require.False(t, Nothing().Empty())
+
+	exists, err := NewRequirement("foo", OperationExists)
+	require.NoError(t, err)
+
+	selector := Everything().Add(exists)
+	require.False(t, selector.Empty())
+	require.Equal(t, []Requirement{exists}, selector.Requirements())
+}
+
+func TestEverythingAndNothing(t *testing.T) {
+	labels := map[string]string{"foo": "bar"}
+	require.True(t, Everything().Matches(labels))
+	require.False(t, Nothing().Matches(labels))
+
+	exists, err := NewRequirement("foo", OperationExists)
+	require.NoError(t, err)
+
+	// Adding requirements to Nothing() keeps it matching nothing.
+	stillNothing := Nothing().Add(exists)
+	require.False(t, stillNothing.Matches(labels))
+}
+
+func TestParseSelector(t *testing.T) {
+	selector, err := ParseSelectorString("foo=bar")
+	require.NoError(t, err)
+	require.True(t, selector.Matches(map[string]string{"foo": "bar"}))
+	require.Equal(t, "foo=bar", selector.String())
+}
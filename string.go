@@ -0,0 +1,89 @@
+package labelselector
+
+import (
+	"fmt"
+	"strings"
+)
+
+// String returns the canonical textual representation of the selector. The
+// output is accepted by Parse, so selectors can be sent over the wire or
+// logged and later reconstructed.
+func (s LabelSelector) String() string {
+	parts := make([]string, 0, len(s.requirements))
+	for _, r := range s.requirements {
+		parts = append(parts, r.String())
+	}
+	return strings.Join(parts, ",")
+}
+
+// String returns the canonical textual representation of the requirement.
+func (r Requirement) String() string {
+	key := quoteIdent(r.Key)
+	switch r.Operation {
+	case OperationExists:
+		return key
+	case OperationNotExists:
+		return "!" + key
+	case OperationEquals:
+		return fmt.Sprintf("%s=%s", key, quoteIdent(r.Value))
+	case OperationNotEquals:
+		return fmt.Sprintf("%s!=%s", key, quoteIdent(r.Value))
+	case OperationLowerThan:
+		return fmt.Sprintf("%s<%s", key, quoteIdent(r.Value))
+	case OperationLowerThanEqual:
+		return fmt.Sprintf("%s<=%s", key, quoteIdent(r.Value))
+	case OperationGreaterThan:
+		return fmt.Sprintf("%s>%s", key, quoteIdent(r.Value))
+	case OperationGreaterThanEqual:
+		return fmt.Sprintf("%s>=%s", key, quoteIdent(r.Value))
+	case OperationIn:
+		return fmt.Sprintf("%s in (%s)", key, quoteIdentList(r.Values))
+	case OperationNotIn:
+		return fmt.Sprintf("%s notin (%s)", key, quoteIdentList(r.Values))
+	}
+	return key
+}
+
+func quoteIdentList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = quoteIdent(v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// quoteIdent wraps s in double quotes (escaping embedded quotes and
+// backslashes) whenever the lexer wouldn't otherwise scan it back as a
+// plain IDENT, i.e. when it is empty, collides with a keyword ("not",
+// "in", "notin") or contains a rune outside isValidIdentRune.
+func quoteIdent(s string) string {
+	if !needsQuoting(s) {
+		return s
+	}
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func needsQuoting(s string) bool {
+	if s == "" {
+		return true
+	}
+	switch strings.ToUpper(s) {
+	case "NOT", "IN", "NOTIN":
+		return true
+	}
+	for _, r := range s {
+		if !isValidIdentRune(r) {
+			return true
+		}
+	}
+	return false
+}
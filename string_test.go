@@ -0,0 +1,180 @@
+package labelselector
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequirementString(t *testing.T) {
+	cases := []struct {
+		name     string
+		req      Requirement
+		expected string
+	}{
+		{
+			name:     "exists",
+			req:      Requirement{Key: "foo", Operation: OperationExists},
+			expected: "foo",
+		},
+		{
+			name:     "not-exists",
+			req:      Requirement{Key: "foo", Operation: OperationNotExists},
+			expected: "!foo",
+		},
+		{
+			name:     "equals",
+			req:      Requirement{Key: "foo", Operation: OperationEquals, Value: "bar"},
+			expected: "foo=bar",
+		},
+		{
+			name:     "not-equals",
+			req:      Requirement{Key: "foo", Operation: OperationNotEquals, Value: "bar"},
+			expected: "foo!=bar",
+		},
+		{
+			name:     "lower than",
+			req:      Requirement{Key: "foo", Operation: OperationLowerThan, Value: "5"},
+			expected: "foo<5",
+		},
+		{
+			name:     "greater than equal",
+			req:      Requirement{Key: "foo", Operation: OperationGreaterThanEqual, Value: "5"},
+			expected: "foo>=5",
+		},
+		{
+			name:     "in",
+			req:      Requirement{Key: "foo", Operation: OperationIn, Values: []string{"a", "b", "c"}},
+			expected: "foo in (a, b, c)",
+		},
+		{
+			name:     "notin",
+			req:      Requirement{Key: "foo", Operation: OperationNotIn, Values: []string{"a", "b"}},
+			expected: "foo notin (a, b)",
+		},
+		{
+			name:     "key with whitespace is quoted",
+			req:      Requirement{Key: "foo bar", Operation: OperationExists},
+			expected: `"foo bar"`,
+		},
+		{
+			name:     "value colliding with a keyword is quoted",
+			req:      Requirement{Key: "foo", Operation: OperationEquals, Value: "in"},
+			expected: `foo="in"`,
+		},
+		{
+			name:     "quoted key with embedded quote is escaped",
+			req:      Requirement{Key: `foo"bar`, Operation: OperationExists},
+			expected: `"foo\"bar"`,
+		},
+		{
+			name:     "quoted key with embedded backslash is escaped",
+			req:      Requirement{Key: `foo\bar`, Operation: OperationExists},
+			expected: `"foo\\bar"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.req.String())
+		})
+	}
+}
+
+// TestQuotedIdentRoundTrip checks that a key with an embedded quote survives
+// a full String -> Parse round trip, not just String's output.
+func TestQuotedIdentRoundTrip(t *testing.T) {
+	cases := []Requirement{
+		{Key: `foo"bar`, Operation: OperationExists},
+		{Key: `foo\bar`, Operation: OperationExists},
+		{Key: `foo\"bar`, Operation: OperationExists},
+		{Key: `a\`, Operation: OperationExists},
+	}
+	for _, req := range cases {
+		parsed, err := ParseString(req.String())
+		require.NoError(t, err)
+		require.Equal(t, LabelSelector{requirements: []Requirement{req}}, parsed)
+	}
+}
+
+func TestLabelSelectorString(t *testing.T) {
+	selector := LabelSelector{
+		requirements: []Requirement{
+			{Key: "foo", Operation: OperationExists},
+			{Key: "bar", Operation: OperationEquals, Value: "baz"},
+		},
+	}
+	require.Equal(t, "foo,bar=baz", selector.String())
+}
+
+// randomIdent produces a random identifier, occasionally padding it with a
+// space or embedding a quote so the quoted-ident path, including its escaping,
+// gets exercised too.
+func randomIdent(r *rand.Rand) string {
+	const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	n := 3 + r.Intn(6)
+	buf := make([]byte, n)
+	for i := range buf {
+		buf[i] = letters[r.Intn(len(letters))]
+	}
+	ident := string(buf)
+	switch r.Intn(8) {
+	case 0:
+		ident = ident[:len(ident)/2] + " " + ident[len(ident)/2:]
+	case 1:
+		ident = ident[:len(ident)/2] + `"` + ident[len(ident)/2:]
+	case 2:
+		ident = ident[:len(ident)/2] + `\` + ident[len(ident)/2:]
+	case 3:
+		ident = ident[:len(ident)/2] + `\"` + ident[len(ident)/2:]
+	}
+	return ident
+}
+
+var randomOperations = []Operation{
+	OperationExists, OperationNotExists,
+	OperationEquals, OperationNotEquals,
+	OperationIn, OperationNotIn,
+	OperationLowerThan, OperationLowerThanEqual,
+	OperationGreaterThan, OperationGreaterThanEqual,
+}
+
+func randomRequirement(r *rand.Rand) Requirement {
+	req := Requirement{
+		Key:       randomIdent(r),
+		Operation: randomOperations[r.Intn(len(randomOperations))],
+	}
+	switch req.Operation {
+	case OperationEquals, OperationNotEquals:
+		req.Value = randomIdent(r)
+	case OperationLowerThan, OperationLowerThanEqual, OperationGreaterThan, OperationGreaterThanEqual:
+		req.Value = strconv.Itoa(r.Intn(1000))
+	case OperationIn, OperationNotIn:
+		values := make([]string, 1+r.Intn(3))
+		for i := range values {
+			values[i] = randomIdent(r)
+		}
+		req.Values = values
+	}
+	return req
+}
+
+// TestLabelSelectorStringRoundTrip formats random selectors and checks that
+// re-parsing them reproduces the original value.
+func TestLabelSelectorStringRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 200; i++ {
+		reqs := make([]Requirement, 1+r.Intn(5))
+		for j := range reqs {
+			reqs[j] = randomRequirement(r)
+		}
+		selector := LabelSelector{requirements: reqs}
+
+		str := selector.String()
+		parsed, err := ParseString(str)
+		require.NoError(t, err, str)
+		require.Equal(t, selector, parsed, str)
+	}
+}
@@ -0,0 +1,143 @@
+package labelselector
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	dnsSubdomainMaxLength = 253
+	nameMaxLength         = 63
+	valueMaxLength        = 63
+)
+
+var (
+	dnsLabelRegexp    = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	qualifiedValueFmt = `[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?`
+	qualifiedValueRe  = regexp.MustCompile(`^` + qualifiedValueFmt + `$`)
+)
+
+// FieldError reports a single field of a Requirement that failed
+// validation.
+type FieldError struct {
+	Field    string
+	BadValue string
+	Detail   string
+}
+
+// Error implements the error interface.
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: invalid value %q: %s", e.Field, e.BadValue, e.Detail)
+}
+
+// ValidationErrors is the set of FieldErrors found while validating a
+// LabelSelector.
+type ValidationErrors []FieldError
+
+// Error implements the error interface, reporting the first error and how
+// many more were found.
+func (e ValidationErrors) Error() string {
+	switch len(e) {
+	case 0:
+		return "no errors"
+	case 1:
+		return e[0].Error()
+	}
+	var b strings.Builder
+	b.WriteString(e[0].Error())
+	fmt.Fprintf(&b, " (and %d more errors)", len(e)-1)
+	return b.String()
+}
+
+// Validate checks every requirement of s against the Kubernetes label
+// key/value rules: an optional DNS-1123 subdomain prefix before a '/', a
+// name part of at most 63 characters matching the qualified-name pattern,
+// values of at most 63 characters matching the same pattern, and, for the
+// ordering operators, a Value that parses as an int64.
+func Validate(s LabelSelector) ValidationErrors {
+	var errs ValidationErrors
+	for _, r := range s.Requirements() {
+		errs = append(errs, validateKey(r.Key)...)
+		switch r.Operation {
+		case OperationEquals, OperationNotEquals:
+			errs = append(errs, validateValue(r.Value)...)
+		case OperationIn, OperationNotIn:
+			for _, v := range r.Values {
+				errs = append(errs, validateValue(v)...)
+			}
+		case OperationLowerThan, OperationLowerThanEqual, OperationGreaterThan, OperationGreaterThanEqual:
+			if _, err := strconv.ParseInt(r.Value, 10, 64); err != nil {
+				errs = append(errs, FieldError{
+					Field:    "value",
+					BadValue: r.Value,
+					Detail:   "must be a valid int64",
+				})
+			}
+		}
+	}
+	return errs
+}
+
+func validateKey(key string) ValidationErrors {
+	var errs ValidationErrors
+
+	name := key
+	if idx := strings.Index(key, "/"); idx >= 0 {
+		prefix := key[:idx]
+		name = key[idx+1:]
+		if len(prefix) > dnsSubdomainMaxLength {
+			errs = append(errs, FieldError{
+				Field:    "key",
+				BadValue: key,
+				Detail:   fmt.Sprintf("prefix must be no more than %d characters", dnsSubdomainMaxLength),
+			})
+		}
+		for _, label := range strings.Split(prefix, ".") {
+			if !dnsLabelRegexp.MatchString(label) {
+				errs = append(errs, FieldError{
+					Field:    "key",
+					BadValue: key,
+					Detail:   "prefix must be a lowercase DNS-1123 subdomain",
+				})
+				break
+			}
+		}
+	}
+
+	if len(name) > nameMaxLength {
+		errs = append(errs, FieldError{
+			Field:    "key",
+			BadValue: key,
+			Detail:   fmt.Sprintf("name part must be no more than %d characters", nameMaxLength),
+		})
+	}
+	if !qualifiedValueRe.MatchString(name) {
+		errs = append(errs, FieldError{
+			Field:    "key",
+			BadValue: key,
+			Detail:   "name part must match " + qualifiedValueFmt,
+		})
+	}
+	return errs
+}
+
+func validateValue(value string) ValidationErrors {
+	var errs ValidationErrors
+	if len(value) > valueMaxLength {
+		errs = append(errs, FieldError{
+			Field:    "value",
+			BadValue: value,
+			Detail:   fmt.Sprintf("must be no more than %d characters", valueMaxLength),
+		})
+	}
+	if !qualifiedValueRe.MatchString(value) {
+		errs = append(errs, FieldError{
+			Field:    "value",
+			BadValue: value,
+			Detail:   "must match " + qualifiedValueFmt,
+		})
+	}
+	return errs
+}
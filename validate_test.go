@@ -0,0 +1,148 @@
+package labelselector
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name        string
+		selector    LabelSelector
+		expectedErr int // number of expected FieldErrors, 0 means valid
+	}{
+		{
+			name: "plain key and value are valid",
+			selector: LabelSelector{
+				requirements: []Requirement{
+					{Key: "foo", Operation: OperationEquals, Value: "bar"},
+				},
+			},
+		},
+		{
+			name: "key with valid dns prefix is valid",
+			selector: LabelSelector{
+				requirements: []Requirement{
+					{Key: "example.com/foo", Operation: OperationExists},
+				},
+			},
+		},
+		{
+			name: "key with more than one slash is rejected",
+			selector: LabelSelector{
+				requirements: []Requirement{
+					{Key: "foo/bar/baz", Operation: OperationExists},
+				},
+			},
+			expectedErr: 1,
+		},
+		{
+			name: "name part longer than 63 characters is rejected",
+			selector: LabelSelector{
+				requirements: []Requirement{
+					{Key: strings.Repeat("a", 300), Operation: OperationExists},
+				},
+			},
+			expectedErr: 1,
+		},
+		{
+			name: "uppercase dns prefix is rejected",
+			selector: LabelSelector{
+				requirements: []Requirement{
+					{Key: "Example.com/foo", Operation: OperationExists},
+				},
+			},
+			expectedErr: 1,
+		},
+		{
+			name: "value with invalid characters is rejected",
+			selector: LabelSelector{
+				requirements: []Requirement{
+					{Key: "foo", Operation: OperationEquals, Value: "a b"},
+				},
+			},
+			expectedErr: 1,
+		},
+		{
+			name: "value longer than 64 characters is rejected",
+			selector: LabelSelector{
+				requirements: []Requirement{
+					{Key: "foo", Operation: OperationEquals, Value: strings.Repeat("a", 65)},
+				},
+			},
+			expectedErr: 1,
+		},
+		{
+			name: "value of exactly 63 characters is valid",
+			selector: LabelSelector{
+				requirements: []Requirement{
+					{Key: "foo", Operation: OperationEquals, Value: strings.Repeat("a", 63)},
+				},
+			},
+		},
+		{
+			name: "value of exactly 64 characters is rejected",
+			selector: LabelSelector{
+				requirements: []Requirement{
+					{Key: "foo", Operation: OperationEquals, Value: strings.Repeat("a", 64)},
+				},
+			},
+			expectedErr: 1,
+		},
+		{
+			name: "non-numeric ordering value is rejected",
+			selector: LabelSelector{
+				requirements: []Requirement{
+					{Key: "foo", Operation: OperationLowerThan, Value: "notanumber"},
+				},
+			},
+			expectedErr: 1,
+		},
+		{
+			name: "numeric ordering value is valid",
+			selector: LabelSelector{
+				requirements: []Requirement{
+					{Key: "foo", Operation: OperationLowerThan, Value: "5"},
+				},
+			},
+		},
+		{
+			name: "invalid in-list value is rejected",
+			selector: LabelSelector{
+				requirements: []Requirement{
+					{Key: "foo", Operation: OperationIn, Values: []string{"a", "b c"}},
+				},
+			},
+			expectedErr: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := Validate(tc.selector)
+			require.Len(t, errs, tc.expectedErr)
+		})
+	}
+}
+
+func TestParserSetValidate(t *testing.T) {
+	parser := NewParser(strings.NewReader("foo/bar/baz=ok"))
+	parser.SetValidate(true)
+
+	_, err := parser.Parse()
+	require.Error(t, err)
+	verrs, ok := err.(ValidationErrors)
+	require.True(t, ok, "expected ValidationErrors, got %T", err)
+	require.Len(t, verrs, 1)
+	require.Equal(t, "key", verrs[0].Field)
+}
+
+func TestValidationErrorsError(t *testing.T) {
+	errs := ValidationErrors{
+		{Field: "key", BadValue: "bad", Detail: "first"},
+		{Field: "value", BadValue: "worse", Detail: "second"},
+	}
+	require.Equal(t, `key: invalid value "bad": first (and 1 more errors)`, errs.Error())
+}